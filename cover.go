@@ -0,0 +1,66 @@
+package geohash
+
+// Cover returns the minimal set of GeoHash cells at the given precision
+// (character length) that together fully cover the bounding box defined
+// by its top-left and bottom-right corners. If bottomRightLon is less
+// than topLeftLon the box is assumed to cross the antimeridian and is
+// split into two boxes joined at +-180 longitude.
+func Cover(topLeftLat, topLeftLon, bottomRightLat, bottomRightLon float64, precision int) []GeoHash {
+
+	if precision <= 0 {
+		return nil
+	}
+
+	if bottomRightLon < topLeftLon {
+		west := coverBox(topLeftLat, topLeftLon, bottomRightLat, 180.0, precision)
+		east := coverBox(topLeftLat, -180.0, bottomRightLat, bottomRightLon, precision)
+		return append(west, east...)
+	}
+
+	return coverBox(topLeftLat, topLeftLon, bottomRightLat, bottomRightLon, precision)
+}
+
+// coverBox covers a bounding box that does not cross the antimeridian.
+func coverBox(topLeftLat, topLeftLon, bottomRightLat, bottomRightLon float64, precision int) []GeoHash {
+
+	var cells []GeoHash
+
+	row := Encode(topLeftLat, topLeftLon, precision)
+	for {
+		latMin, latDelta, _, _ := row.Decode()
+		if latMin+2*latDelta < bottomRightLat {
+			break
+		}
+
+		cell := row
+		for {
+			_, _, lonMin, lonDelta := cell.Decode()
+			if lonMin > bottomRightLon {
+				break
+			}
+
+			cells = append(cells, cell)
+			if lonMin+2*lonDelta >= bottomRightLon {
+				break
+			}
+
+			next, err := cell.Nbr(EAST)
+			if err != nil {
+				break
+			}
+			cell = next
+		}
+
+		if latMin <= bottomRightLat {
+			break
+		}
+
+		next, err := row.Nbr(SOUTH)
+		if err != nil {
+			break
+		}
+		row = next
+	}
+
+	return cells
+}
@@ -0,0 +1,37 @@
+package geohash
+
+import "testing"
+
+func TestCover(t *testing.T) {
+
+	cells := Cover(40.0, -74.0, 39.5, -73.0, 5)
+	if len(cells) == 0 {
+		t.Fatal("Cover returned no cells")
+	}
+
+	seen := make(map[GeoHash]bool)
+	for _, c := range cells {
+		if len(c) != 5 {
+			t.Errorf("Cell %s has unexpected length %d", c, len(c))
+		}
+		if seen[c] {
+			t.Errorf("Cell %s returned more than once", c)
+		}
+		seen[c] = true
+
+		latMin, latDelta, lonMin, lonDelta := c.Decode()
+		if latMin+2*latDelta < 39.5 || latMin > 40.0 || lonMin+2*lonDelta < -74.0 || lonMin > -73.0 {
+			t.Errorf("Cell %s falls outside query box", c)
+		}
+	}
+
+	t.Logf("Covered box with %d cells", len(cells))
+}
+
+func TestCoverDateline(t *testing.T) {
+
+	cells := Cover(10.0, 179.5, 9.5, -179.5, 4)
+	if len(cells) == 0 {
+		t.Fatal("Cover returned no cells across the dateline")
+	}
+}
@@ -0,0 +1,88 @@
+package geohash
+
+import "math"
+
+// earthRadiusMeters is the mean radius of the earth in meters, used by
+// the haversine distance calculation.
+const earthRadiusMeters = 6371000.0
+
+// centroid returns the center point of the cell defined by the GeoHash.
+func (gh GeoHash) centroid() (lat, lon float64) {
+	latMin, latDelta, lonMin, lonDelta := gh.Decode()
+	return latMin + latDelta, lonMin + lonDelta
+}
+
+// Distance returns the great-circle distance, in meters, between the
+// centroids of gh1 and gh2 using the haversine formula.
+func Distance(gh1, gh2 GeoHash) float64 {
+
+	lat1, lon1 := gh1.centroid()
+	lat2, lon2 := gh2.centroid()
+
+	return haversine(lat1, lon1, lat2, lon2)
+}
+
+// WithinRadius returns the GeoHash cells at the given precision whose
+// centroids lie within radiusMeters of (centerLat, centerLon). Candidate
+// cells are discovered by a breadth-first walk outward from the center
+// cell through Nbr, bounded by the enclosing lat/lon box of the radius,
+// and confirmed with an exact haversine check.
+func WithinRadius(centerLat, centerLon, radiusMeters float64, precision int) []GeoHash {
+
+	if precision <= 0 || radiusMeters < 0 {
+		return nil
+	}
+
+	deltaLat := radiusMeters / 111320.0
+	deltaLon := radiusMeters / (111320.0 * math.Cos(centerLat*math.Pi/180.0))
+
+	latLo := centerLat - deltaLat
+	latHi := centerLat + deltaLat
+	lonLo := centerLon - deltaLon
+	lonHi := centerLon + deltaLon
+
+	center := Encode(centerLat, centerLon, precision)
+	visited := map[GeoHash]bool{center: true}
+	queue := []GeoHash{center}
+
+	var result []GeoHash
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		cLat, cLon := cur.centroid()
+		if cLat < latLo || cLat > latHi || cLon < lonLo || cLon > lonHi {
+			continue
+		}
+
+		if haversine(centerLat, centerLon, cLat, cLon) > radiusMeters {
+			continue
+		}
+
+		result = append(result, cur)
+		for _, dir := range [4]Direction{NORTH, EAST, SOUTH, WEST} {
+			nbr, err := cur.Nbr(dir)
+			if err == nil && !visited[nbr] {
+				visited[nbr] = true
+				queue = append(queue, nbr)
+			}
+		}
+	}
+
+	return result
+}
+
+// haversine returns the great-circle distance, in meters, between two
+// points given by latitude/longitude in degrees.
+func haversine(lat1, lon1, lat2, lon2 float64) float64 {
+
+	rad := math.Pi / 180.0
+	dLat := (lat2 - lat1) * rad
+	dLon := (lon2 - lon1) * rad
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	return earthRadiusMeters * c
+}
@@ -0,0 +1,61 @@
+package geohash
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+
+	// Two well known points roughly 5837km apart: Paris and New York.
+	paris := New(48.8566, 2.3522, LatMaxPrecision, LonMaxPrecision)
+	newYork := New(40.7128, -74.0060, LatMaxPrecision, LonMaxPrecision)
+
+	d := Distance(paris, newYork)
+	if math.Abs(d-5837000) > 50000 {
+		t.Errorf("Distance(paris, newYork) = %g, want approx 5837000", d)
+	}
+
+	same := Distance(paris, paris)
+	if same > 1.0 {
+		t.Errorf("Distance(paris, paris) = %g, want ~0", same)
+	}
+}
+
+func TestWithinRadius(t *testing.T) {
+
+	// Sydney.
+	lat, lon := -33.8688, 151.2093
+
+	cells := WithinRadius(lat, lon, 5000, 6)
+	if len(cells) == 0 {
+		t.Fatal("WithinRadius returned no cells")
+	}
+
+	for _, c := range cells {
+		cLat, cLon := c.centroid()
+		if d := haversine(lat, lon, cLat, cLon); d > 5000 {
+			t.Errorf("Cell %s centroid is %gm from center, outside radius", c, d)
+		}
+	}
+
+	t.Logf("Found %d cells within radius", len(cells))
+}
+
+// TestWithinRadiusNearPole covers the BFS walking outward past a cell
+// built entirely of boundary characters, which previously panicked in
+// nbr() when the carry recursion ran off the top of the hash.
+func TestWithinRadiusNearPole(t *testing.T) {
+
+	cells := WithinRadius(89.999, 30.0, 2000000, 2)
+	if len(cells) == 0 {
+		t.Fatal("WithinRadius returned no cells near the pole")
+	}
+
+	for _, c := range cells {
+		cLat, cLon := c.centroid()
+		if d := haversine(89.999, 30.0, cLat, cLon); d > 2000000 {
+			t.Errorf("Cell %s centroid is %gm from center, outside radius", c, d)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package geohash
+
+import "math"
+
+// Encode returns the GeoHash of (lat,lon) as exactly chars base32
+// characters, the "precision" parameter used by most geohash
+// implementations, as an alternative to the tolerance-based New.
+func Encode(lat, lon float64, chars int) GeoHash {
+	return GeoHash(appendHash(make([]byte, 0, chars), lat, lon, chars))
+}
+
+// appendHash appends the chars-character GeoHash of (lat,lon) to dst
+// and returns the extended slice, using the same bit-decision algorithm
+// as New but terminating on character count rather than tolerance.
+func appendHash(dst []byte, lat, lon float64, chars int) []byte {
+
+	lat = math.Mod(math.Mod(lat-90.0, 180.0)+180, 180) - 90.0
+	lon = math.Mod(math.Mod(lon-180.0, 360.0)+360, 360) - 180.0
+
+	latMin, latDelta := -90.0, 90.0
+	lonMin, lonDelta := -180.0, 180.0
+
+	ind := 0
+	bit := 0x10
+	for len(dst) < chars {
+		if lon > lonMin+lonDelta {
+			ind += bit
+			lonMin += lonDelta
+		}
+		bit >>= 1
+
+		if bit == 0 {
+			dst = append(dst, base32[ind])
+			ind = 0
+			bit = 0x10
+			if len(dst) == chars {
+				break
+			}
+		}
+
+		if lat > latMin+latDelta {
+			ind += bit
+			latMin += latDelta
+		}
+		bit >>= 1
+
+		if bit == 0 {
+			dst = append(dst, base32[ind])
+			ind = 0
+			bit = 0x10
+			if len(dst) == chars {
+				break
+			}
+		}
+
+		lonDelta *= 0.5
+		latDelta *= 0.5
+	}
+
+	return dst
+}
+
+// Encoder encodes many points into GeoHashes without allocating a new
+// byte slice for each one; reuse a single Encoder across calls.
+type Encoder struct {
+	buf []byte
+}
+
+// EncodeTo encodes (lat,lon) into chars base32 characters and appends
+// them to dst, returning the extended slice (in the style of
+// strconv.AppendInt). If dst is nil, e's internal buffer is reused
+// instead of allocating a new one.
+func (e *Encoder) EncodeTo(dst []byte, lat, lon float64, chars int) []byte {
+
+	if dst == nil {
+		dst = e.buf[:0]
+	}
+
+	dst = appendHash(dst, lat, lon, chars)
+	e.buf = dst
+	return dst
+}
+
+// DecodeCenter returns the centroid of the cell represented by gh, as
+// an alternative to the bounding box returned by Decode.
+func (gh GeoHash) DecodeCenter() (lat, lon float64) {
+	return gh.centroid()
+}
@@ -0,0 +1,62 @@
+package geohash
+
+import "testing"
+
+func TestEncodeMatchesNew(t *testing.T) {
+
+	points := []struct{ lat, lon float64 }{
+		{51.5074, -0.1278},   // London
+		{-33.8688, 151.2093}, // Sydney
+		{89.9, 0.0},          // near north pole
+		{10.0, 179.999},      // near the antimeridian
+	}
+
+	for _, p := range points {
+		for _, chars := range []int{1, 3, 5, 8, 11} {
+			got := Encode(p.lat, p.lon, chars)
+
+			want := New(p.lat, p.lon, LatMaxPrecision, LonMaxPrecision)
+			if len(want) > chars {
+				want = want[:chars]
+			}
+
+			if got != want {
+				t.Errorf("lat=%g lon=%g chars=%d: Encode = %s, want %s", p.lat, p.lon, chars, got, want)
+			}
+			if len(got) != chars {
+				t.Errorf("lat=%g lon=%g chars=%d: Encode has length %d", p.lat, p.lon, chars, len(got))
+			}
+		}
+	}
+}
+
+func TestEncoderEncodeTo(t *testing.T) {
+
+	var e Encoder
+	lat, lon := -33.8688, 151.2093 // Sydney
+
+	first := e.EncodeTo(nil, lat, lon, 6)
+	firstCopy := string(first)
+
+	second := e.EncodeTo(nil, lat, lon, 6)
+	if string(second) != firstCopy {
+		t.Errorf("EncodeTo reuse produced %s, want %s", second, firstCopy)
+	}
+
+	dst := make([]byte, 0, 6)
+	got := e.EncodeTo(dst, lat, lon, 6)
+	if GeoHash(got) != Encode(lat, lon, 6) {
+		t.Errorf("EncodeTo(dst,...) = %s, want %s", got, Encode(lat, lon, 6))
+	}
+}
+
+func TestDecodeCenter(t *testing.T) {
+
+	lat, lon := 35.6895, 139.6917 // Tokyo
+	gh := Encode(lat, lon, 8)
+
+	cLat, cLon := gh.DecodeCenter()
+	if d := Distance(gh, Encode(cLat, cLon, 8)); d > 1.0 {
+		t.Errorf("DecodeCenter() not within own cell: got (%g,%g), d=%g", cLat, cLon, d)
+	}
+}
@@ -13,7 +13,8 @@ import (
 type GeoHash string
 
 // Direction is used to specify the neighbours of each cell
-// as: NORTH, SOUTH, EAST or WEST.
+// as: NORTH, SOUTH, EAST, WEST, NORTHEAST, SOUTHEAST, SOUTHWEST or
+// NORTHWEST.
 type Direction int
 
 // LatMaxPrecision and LonMaxPrecision are the minimum size of a
@@ -48,6 +49,10 @@ const (
 	EAST
 	SOUTH
 	WEST
+	NORTHEAST
+	SOUTHEAST
+	SOUTHWEST
+	NORTHWEST
 )
 
 // Creates a GeoHash from a (lat)itude, a (lon)gitude.
@@ -230,27 +235,60 @@ func isValid(hash string) bool {
 }
 
 // Nbr returns the GeoHash in the direction specified by the input
-// Direction dir.
+// Direction dir. The four diagonal directions are derived by composing
+// two cardinal Nbr calls (e.g. NORTHEAST is north-then-east).
+// NORTH of a cell in the top row, and SOUTH of a cell in the bottom
+// row, is the cell of the same row 180 degrees away in longitude.
 // Returns an error if the GeoHash contains invalid parameters
 // or if an the Direction is invalid.
 func (gh GeoHash) Nbr(dir Direction) (GeoHash, error) {
 
-	var newgh GeoHash
-	var err error
-	if dir >= NORTH && dir <= WEST {
-		if isValid(string(gh)) {
-			newgh = GeoHash(string(nbr([]byte(string(gh)), dir)))
-		} else {
-			err = fmt.Errorf("Hash contains illegal characters")
+	if !isValid(string(gh)) {
+		return "", fmt.Errorf("Hash contains illegal characters")
+	}
+
+	switch dir {
+	case NORTH, SOUTH:
+		latMin, latDelta, lonMin, lonDelta := gh.Decode()
+		if (dir == NORTH && latMin+2*latDelta >= 90.0) || (dir == SOUTH && latMin <= -90.0) {
+			// Across the pole: the cell of the same row 180 degrees away.
+			return Encode(latMin+latDelta, lonMin+lonDelta+180.0, len(gh)), nil
 		}
-	} else {
-		err = fmt.Errorf("Illegal input direction")
+		return GeoHash(string(nbr([]byte(string(gh)), dir))), nil
+	case EAST, WEST:
+		return GeoHash(string(nbr([]byte(string(gh)), dir))), nil
+	case NORTHEAST:
+		return gh.compose(NORTH, EAST)
+	case SOUTHEAST:
+		return gh.compose(SOUTH, EAST)
+	case SOUTHWEST:
+		return gh.compose(SOUTH, WEST)
+	case NORTHWEST:
+		return gh.compose(NORTH, WEST)
 	}
-	return newgh, err
+	return "", fmt.Errorf("Illegal input direction")
+}
+
+// compose returns the neighbour reached by moving first in direction
+// first, then in direction second.
+func (gh GeoHash) compose(first, second Direction) (GeoHash, error) {
+	mid, err := gh.Nbr(first)
+	if err != nil {
+		return "", err
+	}
+	return mid.Nbr(second)
 }
 
 func nbr(hash []byte, dir Direction) []byte {
 
+	if len(hash) == 0 {
+		// No parent level to carry into: the caller's last character
+		// wraps on its own, which for EAST and WEST crosses the
+		// antimeridian. Nbr handles NORTH and SOUTH across a pole before
+		// calling nbr, so those never carry this far.
+		return hash
+	}
+
 	base := hash[0 : len(hash)-1]
 	last := hash[len(hash)-1]
 	even := len(hash)%2 == 0
@@ -0,0 +1,151 @@
+package geohash
+
+import (
+	"math"
+	"strings"
+)
+
+// GeoHashInt is a 64-bit interleaved (Morton/Z-order) integer encoding
+// of a geohash cell. The low precisionBits bits store the number of
+// bits used per dimension; the remaining high bits hold the
+// interleaved longitude/latitude bits, most significant first,
+// matching the bit order used by GeoHash/New.
+type GeoHashInt uint64
+
+const (
+	// precisionBits is the number of low bits of a GeoHashInt reserved
+	// for storing the per-dimension bit count, so that Decode can
+	// reconstruct the cell without external context.
+	precisionBits = 6
+
+	// maxIntBits is the largest per-dimension bit count that fits
+	// alongside precisionBits in a uint64 (2*maxIntBits + precisionBits <= 64).
+	maxIntBits = 29
+)
+
+// EncodeInt encodes (lat,lon) as a GeoHashInt using bits bits per
+// dimension (capped at maxIntBits). lat and lon are projected into
+// (-90,90) and (-180,180) as in New.
+func EncodeInt(lat, lon float64, bits uint) GeoHashInt {
+
+	if bits > maxIntBits {
+		bits = maxIntBits
+	}
+
+	lat = math.Mod(math.Mod(lat-90.0, 180.0)+180, 180) - 90.0
+	lon = math.Mod(math.Mod(lon-180.0, 360.0)+360, 360) - 180.0
+
+	latMin, latDelta := -90.0, 90.0
+	lonMin, lonDelta := -180.0, 180.0
+
+	var interleaved uint64
+	for i := uint(0); i < bits; i++ {
+		var lonBit, latBit uint64
+
+		if lon > lonMin+lonDelta {
+			lonBit = 1
+			lonMin += lonDelta
+		}
+		lonDelta *= 0.5
+
+		if lat > latMin+latDelta {
+			latBit = 1
+			latMin += latDelta
+		}
+		latDelta *= 0.5
+
+		interleaved = interleaved<<1 | lonBit
+		interleaved = interleaved<<1 | latBit
+	}
+
+	return GeoHashInt(interleaved<<precisionBits | uint64(bits))
+}
+
+// Decode returns the bounding box of the cell represented by ghi, in
+// the same (latMin, latDelta, lonMin, lonDelta) form as GeoHash.Decode.
+func (ghi GeoHashInt) Decode() (latMin, latDelta, lonMin, lonDelta float64) {
+
+	bits := uint(ghi) & (1<<precisionBits - 1)
+	interleaved := uint64(ghi) >> precisionBits
+
+	latMin, latDelta = -90.0, 90.0
+	lonMin, lonDelta = -180.0, 180.0
+
+	for i := uint(0); i < bits; i++ {
+		shift := 2 * (bits - 1 - i)
+		lonBit := interleaved >> (shift + 1) & 1
+		latBit := interleaved >> shift & 1
+
+		if lonBit == 1 {
+			lonMin += lonDelta
+		}
+		lonDelta *= 0.5
+
+		if latBit == 1 {
+			latMin += latDelta
+		}
+		latDelta *= 0.5
+	}
+
+	return latMin, latDelta, lonMin, lonDelta
+}
+
+// ToBase32 converts ghi into the equivalent base32 GeoHash of the
+// given character length, padding or truncating the interleaved bits
+// as needed.
+func (ghi GeoHashInt) ToBase32(chars int) GeoHash {
+
+	bits := uint(ghi) & (1<<precisionBits - 1)
+	interleaved := uint64(ghi) >> precisionBits
+	totalBits := 2 * bits
+
+	neededBits := uint(chars) * 5
+	if neededBits > totalBits {
+		interleaved <<= neededBits - totalBits
+	} else if neededBits < totalBits {
+		interleaved >>= totalBits - neededBits
+	}
+
+	hash := make([]byte, chars)
+	for i := 0; i < chars; i++ {
+		shift := neededBits - uint(i+1)*5
+		hash[i] = base32[interleaved>>shift&0x1f]
+	}
+
+	return GeoHash(hash)
+}
+
+// ToInt converts gh into its GeoHashInt representation, using as many
+// bits per dimension as the hash's character length allows (dropping
+// the final bit if the character length is odd, since each base32
+// character holds an odd number of bits).
+func (gh GeoHash) ToInt() GeoHashInt {
+
+	hash := string(gh)
+
+	// Only the characters needed to fill maxIntBits bits per dimension
+	// can matter; keeping any more would overflow the uint64 used below
+	// to accumulate raw bits before they are ever used.
+	if maxChars := (maxIntBits*2)/5 + 1; len(hash) > maxChars {
+		hash = hash[:maxChars]
+	}
+	totalBits := uint(len(hash)) * 5
+
+	var raw uint64
+	for i := 0; i < len(hash); i++ {
+		ind := strings.IndexByte(base32, hash[i])
+		if ind < 0 {
+			ind = 0
+		}
+		raw = raw<<5 | uint64(ind)
+	}
+
+	bits := totalBits / 2
+	if bits > maxIntBits {
+		bits = maxIntBits
+	}
+	usedBits := bits * 2
+
+	interleaved := raw >> (totalBits - usedBits)
+	return GeoHashInt(interleaved<<precisionBits | uint64(bits))
+}
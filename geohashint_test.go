@@ -0,0 +1,77 @@
+package geohash
+
+import "testing"
+
+func TestEncodeIntDecode(t *testing.T) {
+
+	points := []struct{ lat, lon float64 }{
+		{51.5074, -0.1278}, // London
+		{-89.9, -45.0},     // near south pole
+		{10.0, -179.999},   // near the antimeridian
+	}
+
+	for _, p := range points {
+		for _, bits := range []uint{10, 20, 25, 29} {
+			ghi := EncodeInt(p.lat, p.lon, bits)
+			latMin, latDelta, lonMin, lonDelta := ghi.Decode()
+
+			if p.lat < latMin || p.lat > latMin+2*latDelta {
+				t.Errorf("lat=%g lon=%g bits=%d: lat outside decoded box [%g,%g]", p.lat, p.lon, bits, latMin, latMin+2*latDelta)
+			}
+			if p.lon < lonMin || p.lon > lonMin+2*lonDelta {
+				t.Errorf("lat=%g lon=%g bits=%d: lon outside decoded box [%g,%g]", p.lat, p.lon, bits, lonMin, lonMin+2*lonDelta)
+			}
+		}
+	}
+}
+
+func TestIntBase32RoundTrip(t *testing.T) {
+
+	points := []struct{ lat, lon float64 }{
+		{35.6895, 139.6917}, // Tokyo
+		{-33.8688, 151.2093},
+	}
+
+	// A GeoHash character is 5 bits, so only an even character count
+	// maps onto a whole number of per-dimension bits and round-trips
+	// exactly through GeoHashInt.
+	for _, p := range points {
+		gh := New(p.lat, p.lon, LatMaxPrecision, LonMaxPrecision)
+
+		for _, chars := range []int{2, 4, 6, 8} {
+			prefix := gh
+			if len(prefix) > chars {
+				prefix = prefix[:chars]
+			}
+
+			back := prefix.ToInt().ToBase32(chars)
+			if back != prefix {
+				t.Errorf("lat=%g lon=%g chars=%d: got %s, want %s", p.lat, p.lon, chars, back, prefix)
+			}
+		}
+	}
+}
+
+// TestToIntFullPrecision covers ToInt on an untruncated max-precision
+// hash (15 characters), which previously overflowed the uint64 used
+// to accumulate raw bits before maxIntBits was ever applied, silently
+// decoding to an unrelated part of the globe.
+func TestToIntFullPrecision(t *testing.T) {
+
+	points := []struct{ lat, lon float64 }{
+		{40.7128, -74.0060},  // New York
+		{51.5074, -0.1278},   // London
+		{-33.8688, 151.2093}, // Sydney
+	}
+
+	for _, p := range points {
+		gh := New(p.lat, p.lon, LatMaxPrecision, LonMaxPrecision)
+
+		latMin, latDelta, lonMin, lonDelta := gh.ToInt().Decode()
+		lat, lon := latMin+latDelta, lonMin+lonDelta
+
+		if d := haversine(p.lat, p.lon, lat, lon); d > 1000 {
+			t.Errorf("lat=%g lon=%g: ToInt() on %d-char hash decoded to (%g,%g), %gm away", p.lat, p.lon, len(gh), lat, lon, d)
+		}
+	}
+}
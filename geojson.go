@@ -0,0 +1,88 @@
+package geohash
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// geoJSONPoint is the minimal shape needed to parse a GeoJSON Point
+// geometry. Coordinates are [longitude, latitude] per the GeoJSON spec.
+type geoJSONPoint struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+// ParsePoint parses a GeoJSON Point, e.g. {"type":"Point","coordinates":[lon,lat]},
+// and returns its latitude and longitude.
+func ParsePoint(data []byte) (lat, lon float64, err error) {
+
+	var p geoJSONPoint
+	if err = json.Unmarshal(data, &p); err != nil {
+		return 0, 0, err
+	}
+	if p.Type != "Point" {
+		return 0, 0, fmt.Errorf("geohash: not a GeoJSON Point: %q", p.Type)
+	}
+	if len(p.Coordinates) != 2 {
+		return 0, 0, fmt.Errorf("geohash: Point must have 2 coordinates, got %d", len(p.Coordinates))
+	}
+
+	return p.Coordinates[1], p.Coordinates[0], nil
+}
+
+// geoJSONFeature wraps a geometry and its properties, as emitted by
+// GeoJSONPolygon.
+type geoJSONFeature struct {
+	Type       string          `json:"type"`
+	Geometry   geoJSONGeometry `json:"geometry"`
+	Properties map[string]any  `json:"properties"`
+}
+
+type geoJSONGeometry struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+// GeoJSONPolygon returns the cell's bounding box as a GeoJSON Polygon
+// Feature, with the hash string carried in the feature's properties.
+func (gh GeoHash) GeoJSONPolygon() []byte {
+
+	latMin, latDelta, lonMin, lonDelta := gh.Decode()
+	latMax := latMin + 2*latDelta
+	lonMax := lonMin + 2*lonDelta
+
+	ring := [][2]float64{
+		{lonMin, latMin},
+		{lonMax, latMin},
+		{lonMax, latMax},
+		{lonMin, latMax},
+		{lonMin, latMin},
+	}
+
+	feature := geoJSONFeature{
+		Type: "Feature",
+		Geometry: geoJSONGeometry{
+			Type:        "Polygon",
+			Coordinates: [][][2]float64{ring},
+		},
+		Properties: map[string]any{"geohash": string(gh)},
+	}
+
+	data, _ := json.Marshal(feature)
+	return data
+}
+
+// WKT returns the cell's bounding box in Well-Known Text Polygon form.
+func (gh GeoHash) WKT() string {
+
+	latMin, latDelta, lonMin, lonDelta := gh.Decode()
+	latMax := latMin + 2*latDelta
+	lonMax := lonMin + 2*lonDelta
+
+	return fmt.Sprintf("POLYGON((%g %g, %g %g, %g %g, %g %g, %g %g))",
+		lonMin, latMin,
+		lonMax, latMin,
+		lonMax, latMax,
+		lonMin, latMax,
+		lonMin, latMin)
+}
@@ -0,0 +1,58 @@
+package geohash
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParsePoint(t *testing.T) {
+
+	lat, lon, err := ParsePoint([]byte(`{"type":"Point","coordinates":[151.2093,-33.8688]}`))
+	if err != nil {
+		t.Fatalf("ParsePoint error: %s", err)
+	}
+	if lat != -33.8688 || lon != 151.2093 {
+		t.Errorf("ParsePoint = (%g,%g), want (-33.8688,151.2093)", lat, lon)
+	}
+
+	// Near the antimeridian, where a sign or axis-order mistake would
+	// be easy to miss with a more central fixture.
+	lat, lon, err = ParsePoint([]byte(`{"type":"Point","coordinates":[-179.999,10.0]}`))
+	if err != nil {
+		t.Fatalf("ParsePoint error: %s", err)
+	}
+	if lat != 10.0 || lon != -179.999 {
+		t.Errorf("ParsePoint = (%g,%g), want (10,-179.999)", lat, lon)
+	}
+
+	if _, _, err := ParsePoint([]byte(`{"type":"LineString","coordinates":[[0,0],[1,1]]}`)); err == nil {
+		t.Error("expected error for non-Point geometry")
+	}
+}
+
+func TestGeoJSONPolygon(t *testing.T) {
+
+	gh := New(35.6895, 139.6917, LatMaxPrecision, LonMaxPrecision)[:6] // Tokyo
+
+	data := gh.GeoJSONPolygon()
+
+	if !strings.Contains(string(data), `"type":"Polygon"`) {
+		t.Errorf("GeoJSONPolygon output missing Polygon type: %s", data)
+	}
+	if !strings.Contains(string(data), string(gh)) {
+		t.Errorf("GeoJSONPolygon output missing hash string: %s", data)
+	}
+}
+
+func TestWKT(t *testing.T) {
+
+	gh := New(89.9, 0.0, LatMaxPrecision, LonMaxPrecision) // near north pole
+	if len(gh) > 6 {
+		gh = gh[:6]
+	}
+	wkt := gh.WKT()
+
+	if !strings.HasPrefix(wkt, "POLYGON((") || !strings.HasSuffix(wkt, "))") {
+		t.Errorf("WKT() = %q, want POLYGON((...)) form", wkt)
+	}
+}
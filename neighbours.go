@@ -0,0 +1,19 @@
+package geohash
+
+// Neighbours returns the full Moore neighbourhood of the GeoHash: the
+// 8 cells sharing an edge or corner with it, in the fixed order
+// N, NE, E, SE, S, SW, W, NW. Entries corresponding to an invalid
+// GeoHash are returned as the empty GeoHash.
+func (gh GeoHash) Neighbours() [8]GeoHash {
+
+	dirs := [8]Direction{NORTH, NORTHEAST, EAST, SOUTHEAST, SOUTH, SOUTHWEST, WEST, NORTHWEST}
+
+	var out [8]GeoHash
+	for i, dir := range dirs {
+		nbr, err := gh.Nbr(dir)
+		if err == nil {
+			out[i] = nbr
+		}
+	}
+	return out
+}
@@ -0,0 +1,88 @@
+package geohash
+
+import "testing"
+
+func TestNeighbours(t *testing.T) {
+
+	gh := New(51.5074, -0.1278, LatMaxPrecision, LonMaxPrecision)
+	nbrs := gh.Neighbours()
+
+	seen := make(map[GeoHash]bool)
+	for i, nbr := range nbrs {
+		if nbr == "" {
+			t.Errorf("Neighbour %d is empty", i)
+		}
+		if nbr == gh {
+			t.Errorf("Neighbour %d equals the hash itself", i)
+		}
+		seen[nbr] = true
+	}
+
+	if len(seen) != 8 {
+		t.Errorf("Expected 8 distinct neighbours, got %d", len(seen))
+	}
+}
+
+// TestNeighboursNearPole exercises the carry-at-the-pole edge case: a
+// GeoHash built entirely of boundary characters has no parent level to
+// carry into when walking further north/south, and Nbr must step across
+// the pole rather than recurse past an empty hash.
+func TestNeighboursNearPole(t *testing.T) {
+
+	cases := []struct {
+		lat, lon float64
+		chars    int
+	}{
+		{89.9, 0.0, 1},
+		{85.0, 100.0, 2},
+		{-89.9, -45.0, 1},
+		{89.999, 30.0, 2},
+	}
+
+	for _, tc := range cases {
+		gh := Encode(tc.lat, tc.lon, tc.chars)
+		nbrs := gh.Neighbours()
+		for i, nbr := range nbrs {
+			if nbr == "" {
+				t.Errorf("lat=%g lon=%g: neighbour %d is empty", tc.lat, tc.lon, i)
+			}
+		}
+	}
+}
+
+func TestNbrDiagonal(t *testing.T) {
+
+	// Tokyo: away from the fixture used by the other tests in this
+	// package, and far enough from any pole/antimeridian boundary that
+	// NORTH and EAST both move in the expected direction.
+	gh := New(35.6895, 139.6917, LatMaxPrecision, LonMaxPrecision)
+
+	ne, err := gh.Nbr(NORTHEAST)
+	if err != nil {
+		t.Fatalf("Nbr(NORTHEAST) error: %s", err)
+	}
+
+	lat, lon := gh.centroid()
+	neLat, neLon := ne.centroid()
+	if neLat <= lat {
+		t.Errorf("Nbr(NORTHEAST) latitude %g not north of %g", neLat, lat)
+	}
+	if neLon <= lon {
+		t.Errorf("Nbr(NORTHEAST) longitude %g not east of %g", neLon, lon)
+	}
+
+	viaCompose, err := gh.compose(NORTH, EAST)
+	if err != nil {
+		t.Fatalf("compose error: %s", err)
+	}
+	if ne != viaCompose {
+		t.Errorf("Nbr(NORTHEAST)=%s, compose(NORTH,EAST)=%s", ne, viaCompose)
+	}
+
+	// Near the pole, Nbr(NORTHEAST) must step across the pole rather
+	// than panic when the cell already touches the pole.
+	pole := Encode(89.9, 0.0, 1)
+	if _, err := pole.Nbr(NORTHEAST); err != nil {
+		t.Errorf("Nbr(NORTHEAST) near pole: %s", err)
+	}
+}